@@ -0,0 +1,46 @@
+package maybe
+
+import "reflect"
+
+// convertToType attempts to coerce value into T when a direct type assertion
+// fails. It covers numeric-to-numeric widening/narrowing, []byte<->string,
+// and defined types sharing a convertible underlying kind (e.g. type Status
+// int), mirroring the convertToType helper used by gonull.
+func convertToType[T any](value any) (T, bool) {
+	var zero T
+	target := reflect.TypeOf(zero)
+	if target == nil {
+		return zero, false
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch src := value.(type) {
+	case []byte:
+		if target.Kind() == reflect.String {
+			rv = reflect.ValueOf(string(src))
+		}
+	case string:
+		if target.Kind() == reflect.Slice && target.Elem().Kind() == reflect.Uint8 {
+			rv = reflect.ValueOf([]byte(src))
+		}
+	}
+
+	if !rv.IsValid() || !rv.Type().ConvertibleTo(target) {
+		return zero, false
+	}
+
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String, reflect.Bool,
+		reflect.Slice, reflect.Struct:
+		// The Struct case covers a defined type sharing time.Time's
+		// underlying layout (e.g. type MyTime time.Time), since
+		// ConvertibleTo above already confirmed the layouts match.
+		out, ok := rv.Convert(target).Interface().(T)
+		return out, ok
+	}
+
+	return zero, false
+}
@@ -0,0 +1,17 @@
+package maybe
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ScanError reports that a driver value could not be coerced into the
+// destination type expected by Nullable[T].Scan or Optional[T].Scan.
+type ScanError struct {
+	Source reflect.Type
+	Target reflect.Type
+}
+
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("maybe: cannot scan %s into %s", e.Source, e.Target)
+}
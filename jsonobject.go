@@ -0,0 +1,139 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// MarshalJSONObject marshals a struct (or pointer to struct) to a JSON
+// object, skipping any Optional[T] field that is Unset. encoding/json's
+// omitempty cannot express "field was never provided", since it has no way
+// to special-case Optional's Unset state from a plain Marshaler, so PATCH
+// handlers that build their response body from an Optional-bearing struct
+// should call this instead of json.Marshal.
+func MarshalJSONObject(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return []byte("null"), nil
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return json.Marshal(v)
+	}
+
+	rt := rv.Type()
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	wrote := false
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		name, omitempty := jsonFieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		fv := rv.Field(i)
+		if fieldIsUnsetOptional(fv) {
+			continue
+		}
+		if omitempty && fv.IsZero() {
+			continue
+		}
+
+		data, err := marshalJSONObjectField(fv)
+		if err != nil {
+			return nil, err
+		}
+
+		keyData, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if wrote {
+			buf.WriteByte(',')
+		}
+		wrote = true
+
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonFieldName resolves the JSON key and omitempty flag for field from its
+// `json` struct tag, falling back to the Go field name.
+func jsonFieldName(field reflect.StructField) (name string, omitempty bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return field.Name, false
+	}
+
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
+
+// marshalJSONObjectField marshals a single struct field's value. A type
+// with its own MarshalJSON (Optional[T], Nullable[T], ...) is left to
+// encode itself; a plain struct or pointer-to-struct recurses through
+// MarshalJSONObject instead of json.Marshal, so an Optional[T] nested
+// inside it still gets skip-if-Unset treatment rather than serializing as
+// a literal "null".
+func marshalJSONObjectField(fv reflect.Value) ([]byte, error) {
+	if _, ok := fv.Interface().(json.Marshaler); ok {
+		return json.Marshal(fv.Interface())
+	}
+
+	underlying := fv
+	for underlying.Kind() == reflect.Pointer {
+		if underlying.IsNil() {
+			return []byte("null"), nil
+		}
+		underlying = underlying.Elem()
+	}
+
+	if underlying.Kind() == reflect.Struct {
+		return MarshalJSONObject(fv.Interface())
+	}
+
+	return json.Marshal(fv.Interface())
+}
+
+// fieldIsUnsetOptional reports whether fv is an Optional[T] in its Unset
+// state, without knowing T at compile time.
+func fieldIsUnsetOptional(fv reflect.Value) bool {
+	method := fv.MethodByName("IsUnset")
+	if !method.IsValid() {
+		return false
+	}
+
+	out := method.Call(nil)
+	if len(out) != 1 {
+		return false
+	}
+
+	unset, ok := out[0].Interface().(bool)
+	return ok && unset
+}
@@ -0,0 +1,170 @@
+package maybe
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Nullable wraps a value of type T that may be absent, matching SQL NULL
+// semantics. The zero value is invalid (null).
+type Nullable[T any] struct {
+	value T
+	valid bool
+}
+
+// NullableOf returns a Nullable[T] holding value.
+func NullableOf[T any](value T) Nullable[T] {
+	return Nullable[T]{value: value, valid: true}
+}
+
+// Null returns an invalid (null) Nullable[T].
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// IsValid reports whether n holds a non-null value.
+func (n Nullable[T]) IsValid() bool {
+	return n.valid
+}
+
+// Extract returns the held value and whether it is valid.
+func (n Nullable[T]) Extract() (T, bool) {
+	return n.value, n.valid
+}
+
+// ExtractOr returns the held value, or fallback if n is null.
+func (n Nullable[T]) ExtractOr(fallback T) T {
+	if !n.valid {
+		return fallback
+	}
+	return n.value
+}
+
+// ToOption converts n into an Option[T], mapping null to None.
+func (n Nullable[T]) ToOption() Option[T] {
+	if !n.valid {
+		return None[T]()
+	}
+	return Some(n.value)
+}
+
+// Scan implements sql.Scanner via the shared scanCoerce pipeline: a
+// registered Parser (see RegisterParser, MustRegisterEnum) runs first, so a
+// value already of type T is still validated rather than silently
+// accepted, then a direct type assertion, then reflection-based coercion
+// for drivers that return int64/float64/[]byte for narrower or
+// differently-named Go types (e.g. int32, uint64, string). If no path
+// applies, Scan returns a *ScanError naming both types.
+func (n *Nullable[T]) Scan(value any) error {
+	if value == nil {
+		n.value = *new(T)
+		n.valid = false
+		return nil
+	}
+
+	v, ok, err := scanCoerce[T](value)
+	if !ok {
+		return err
+	}
+
+	n.value = v
+	n.valid = true
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if !n.valid {
+		return nil, nil
+	}
+	if v, ok, err := lookupValuer[T](n.value); ok {
+		return v, err
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.value)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.value = *new(T)
+		n.valid = false
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	n.value = v
+	n.valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, letting Nullable[T] round
+// trip through YAML/TOML encoders and URL query decoders built on the same
+// contract when T is time.Time or implements encoding.TextMarshaler and
+// encoding.TextUnmarshaler. For any other T the value is formatted with
+// fmt.Sprint, and UnmarshalText returns an error for that T. A null
+// Nullable marshals to an empty string.
+func (n Nullable[T]) MarshalText() ([]byte, error) {
+	if !n.valid {
+		return []byte{}, nil
+	}
+
+	if reflect.TypeOf(n.value) == timeType {
+		t := any(n.value).(time.Time)
+		return []byte(t.Format(currentTimeFormats()[0])), nil
+	}
+
+	if tm, ok := any(n.value).(encoding.TextMarshaler); ok {
+		return tm.MarshalText()
+	}
+
+	return []byte(fmt.Sprint(n.value)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *Nullable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.value = *new(T)
+		n.valid = false
+		return nil
+	}
+
+	var zero T
+	if reflect.TypeOf(zero) == timeType {
+		t, ok := parseTimeString(string(text))
+		if !ok {
+			return &ScanError{Source: reflect.TypeOf(string(text)), Target: timeType}
+		}
+		n.value = any(t).(T)
+		n.valid = true
+		return nil
+	}
+
+	if tu, ok := any(&zero).(encoding.TextUnmarshaler); ok {
+		if err := tu.UnmarshalText(text); err != nil {
+			return err
+		}
+		n.value = zero
+		n.valid = true
+		return nil
+	}
+
+	return fmt.Errorf("maybe: Nullable[%T] does not implement encoding.TextUnmarshaler", zero)
+}
@@ -0,0 +1,87 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iamNilotpal/maybe"
+)
+
+// Status is an int-backed defined type, representative of enum columns
+// returned by a driver as their underlying kind.
+type Status int
+
+// MyTime is a defined type sharing time.Time's underlying layout, the
+// "compatible alias" case Scan's struct-kind coercion targets.
+type MyTime time.Time
+
+// TestNullableScanCoercion verifies that Scan coerces driver-returned types
+// (int64, float64, []byte, string) into narrower or differently-named
+// Nullable[T] targets instead of failing the direct type assertion.
+func TestNullableScanCoercion(t *testing.T) {
+	t.Run("int64 into int32", func(t *testing.T) {
+		var n maybe.Nullable[int32]
+		if err := n.Scan(int64(7)); err != nil || !n.IsValid() || n.ExtractOr(0) != 7 {
+			t.Errorf("Scan(int64(7)) = (valid=%v, err=%v), want valid int32(7)", n.IsValid(), err)
+		}
+	})
+
+	t.Run("int64 into uint64", func(t *testing.T) {
+		var n maybe.Nullable[uint64]
+		if err := n.Scan(int64(42)); err != nil || !n.IsValid() || n.ExtractOr(0) != 42 {
+			t.Errorf("Scan(int64(42)) = (valid=%v, err=%v), want valid uint64(42)", n.IsValid(), err)
+		}
+	})
+
+	t.Run("float64 into float32", func(t *testing.T) {
+		var n maybe.Nullable[float32]
+		if err := n.Scan(float64(3.5)); err != nil || !n.IsValid() || n.ExtractOr(0) != 3.5 {
+			t.Errorf("Scan(float64(3.5)) = (valid=%v, err=%v), want valid float32(3.5)", n.IsValid(), err)
+		}
+	})
+
+	t.Run("[]byte into string", func(t *testing.T) {
+		var n maybe.Nullable[string]
+		if err := n.Scan([]byte("hello")); err != nil || !n.IsValid() || n.ExtractOr("") != "hello" {
+			t.Errorf("Scan([]byte(hello)) = (valid=%v, err=%v), want valid string", n.IsValid(), err)
+		}
+	})
+
+	t.Run("string into []byte", func(t *testing.T) {
+		var n maybe.Nullable[[]byte]
+		if err := n.Scan("hello"); err != nil || !n.IsValid() || string(n.ExtractOr(nil)) != "hello" {
+			t.Errorf("Scan(\"hello\") = (valid=%v, err=%v), want valid []byte", n.IsValid(), err)
+		}
+	})
+
+	t.Run("int64 into defined type", func(t *testing.T) {
+		var n maybe.Nullable[Status]
+		if err := n.Scan(int64(2)); err != nil || !n.IsValid() || n.ExtractOr(0) != Status(2) {
+			t.Errorf("Scan(int64(2)) = (valid=%v, err=%v), want valid Status(2)", n.IsValid(), err)
+		}
+	})
+
+	t.Run("time.Time into compatible defined struct type", func(t *testing.T) {
+		now := time.Now()
+		var n maybe.Nullable[MyTime]
+		if err := n.Scan(now); err != nil || !n.IsValid() {
+			t.Fatalf("Scan(time.Now()) = (valid=%v, err=%v), want valid MyTime", n.IsValid(), err)
+		}
+		if got := time.Time(n.ExtractOr(MyTime{})); !got.Equal(now) {
+			t.Errorf("Scan(time.Now()) = %v, want %v", got, now)
+		}
+	})
+
+	t.Run("incompatible type returns ScanError", func(t *testing.T) {
+		var n maybe.Nullable[int]
+		err := n.Scan("not a number")
+		var scanErr *maybe.ScanError
+		if !errors.As(err, &scanErr) {
+			t.Fatalf("Scan(\"not a number\") error = %v, want *maybe.ScanError", err)
+		}
+		if n.IsValid() {
+			t.Error("Nullable should remain invalid after a failed Scan")
+		}
+	})
+}
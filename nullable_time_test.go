@@ -0,0 +1,71 @@
+package maybe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iamNilotpal/maybe"
+)
+
+// TestNullableTimeScan verifies that Nullable[time.Time] coerces the
+// string/[]byte shapes MySQL and friends return for DATETIME/TIMESTAMP
+// columns, trying each registered layout in turn.
+func TestNullableTimeScan(t *testing.T) {
+	t.Cleanup(func() { maybe.RegisterTimeFormats() })
+
+	t.Run("default layouts", func(t *testing.T) {
+		var n maybe.Nullable[time.Time]
+		if err := n.Scan("2024-05-01 12:30:00"); err != nil || !n.IsValid() {
+			t.Fatalf("Scan(datetime string) error = %v, valid = %v", err, n.IsValid())
+		}
+		got, _ := n.Extract()
+		if got.Format("2006-01-02 15:04:05") != "2024-05-01 12:30:00" {
+			t.Errorf("parsed time = %v, want 2024-05-01 12:30:00", got)
+		}
+	})
+
+	t.Run("[]byte date-only layout", func(t *testing.T) {
+		var n maybe.Nullable[time.Time]
+		if err := n.Scan([]byte("2024-05-01")); err != nil || !n.IsValid() {
+			t.Fatalf("Scan([]byte date) error = %v, valid = %v", err, n.IsValid())
+		}
+	})
+
+	t.Run("custom registered layout", func(t *testing.T) {
+		maybe.RegisterTimeFormats("01/02/2006")
+		t.Cleanup(func() { maybe.RegisterTimeFormats() })
+
+		var n maybe.Nullable[time.Time]
+		if err := n.Scan("05/01/2024"); err != nil || !n.IsValid() {
+			t.Fatalf("Scan with custom layout error = %v, valid = %v", err, n.IsValid())
+		}
+	})
+}
+
+// TestNullableTextRoundTrip checks MarshalText/UnmarshalText round-tripping,
+// the contract YAML/TOML encoders and url.Values decoders rely on.
+func TestNullableTextRoundTrip(t *testing.T) {
+	original := maybe.NullableOf(time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC))
+
+	text, err := original.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+
+	var roundTripped maybe.Nullable[time.Time]
+	if err := roundTripped.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(%q) error = %v", text, err)
+	}
+
+	got, _ := roundTripped.Extract()
+	want, _ := original.Extract()
+	if !got.Equal(want) {
+		t.Errorf("round-tripped time = %v, want %v", got, want)
+	}
+
+	var null maybe.Nullable[time.Time]
+	data, err := null.MarshalText()
+	if err != nil || string(data) != "" {
+		t.Errorf("MarshalText() of null = (%q, %v), want empty string", data, err)
+	}
+}
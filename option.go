@@ -0,0 +1,67 @@
+package maybe
+
+// Option represents a value that may or may not be present, in the spirit of
+// Rust's Option<T>. The zero value is None.
+type Option[T any] struct {
+	value T
+	some  bool
+}
+
+// Some wraps value in an Option that is present.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, some: true}
+}
+
+// None returns an absent Option[T].
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// IsSome reports whether o holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.some
+}
+
+// IsNone reports whether o is absent.
+func (o Option[T]) IsNone() bool {
+	return !o.some
+}
+
+// Unwrap returns the held value, panicking if o is None.
+func (o Option[T]) Unwrap() T {
+	if !o.some {
+		panic("maybe: Unwrap called on None")
+	}
+	return o.value
+}
+
+// UnwrapOr returns the held value, or fallback if o is None.
+func (o Option[T]) UnwrapOr(fallback T) T {
+	if !o.some {
+		return fallback
+	}
+	return o.value
+}
+
+// Get returns the held value and whether it was present.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.some
+}
+
+// Map applies fn to the held value and returns the transformed Option.
+// None propagates without invoking fn.
+func Map[T, U any](o Option[T], fn func(T) U) Option[U] {
+	if !o.some {
+		return None[U]()
+	}
+	return Some(fn(o.value))
+}
+
+// AndThen chains fn onto the held value, flattening the resulting Option.
+// None propagates without invoking fn.
+func AndThen[T, U any](o Option[T], fn func(T) Option[U]) Option[U] {
+	if !o.some {
+		return None[U]()
+	}
+	return fn(o.value)
+}
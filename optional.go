@@ -0,0 +1,140 @@
+package maybe
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// optionalState distinguishes a field that was never provided from one that
+// was explicitly provided as null.
+type optionalState int
+
+const (
+	optionalUnset optionalState = iota
+	optionalNull
+	optionalSet
+)
+
+// Optional is a tri-state counterpart to Nullable: it distinguishes a value
+// that is Unset (absent from the payload), Null (present but null), or Set
+// to a value. This matters for HTTP PATCH semantics, where Nullable's two
+// states collapse "omitted" and "null" together. The zero value is Unset.
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+// OptionalOf returns an Optional[T] set to value.
+func OptionalOf[T any](value T) Optional[T] {
+	return Optional[T]{value: value, state: optionalSet}
+}
+
+// OptionalNull returns an Optional[T] explicitly set to null.
+func OptionalNull[T any]() Optional[T] {
+	return Optional[T]{state: optionalNull}
+}
+
+// UnsetOptional returns an Optional[T] in its Unset state. It is equivalent
+// to the zero value and exists for readability at call sites.
+func UnsetOptional[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// IsUnset reports whether o was never provided.
+func (o Optional[T]) IsUnset() bool {
+	return o.state == optionalUnset
+}
+
+// IsNull reports whether o was provided as an explicit null.
+func (o Optional[T]) IsNull() bool {
+	return o.state == optionalNull
+}
+
+// IsSet reports whether o holds a value.
+func (o Optional[T]) IsSet() bool {
+	return o.state == optionalSet
+}
+
+// Get returns the held value and whether o is Set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// ToNullable collapses o to a Nullable[T]: Set maps to a valid value, Unset
+// and Null both map to null.
+func (o Optional[T]) ToNullable() Nullable[T] {
+	if o.state != optionalSet {
+		return Null[T]()
+	}
+	return NullableOf(o.value)
+}
+
+// ToOption collapses o to an Option[T]: Set maps to Some, Unset and Null
+// both map to None.
+func (o Optional[T]) ToOption() Option[T] {
+	if o.state != optionalSet {
+		return None[T]()
+	}
+	return Some(o.value)
+}
+
+// MarshalJSON implements json.Marshaler. Unset marshals the same as Null
+// ("null") here; callers that need to omit Unset fields entirely must
+// marshal the enclosing struct with MarshalJSONObject instead, since
+// encoding/json has no "omitempty" hook a Marshaler can react to.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != optionalSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It is only invoked by
+// encoding/json when the field is present in the payload, so the zero value
+// (Unset) naturally represents an absent field without any extra work here.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		o.value = *new(T)
+		o.state = optionalNull
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	o.value = v
+	o.state = optionalSet
+	return nil
+}
+
+// Scan implements sql.Scanner, treating a SQL NULL as the Null state, via
+// the same scanCoerce pipeline as Nullable.Scan.
+func (o *Optional[T]) Scan(value any) error {
+	if value == nil {
+		o.value = *new(T)
+		o.state = optionalNull
+		return nil
+	}
+
+	v, ok, err := scanCoerce[T](value)
+	if !ok {
+		return err
+	}
+
+	o.value = v
+	o.state = optionalSet
+	return nil
+}
+
+// Value implements driver.Valuer. Both Unset and Null write SQL NULL.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if o.state != optionalSet {
+		return nil, nil
+	}
+	if v, ok, err := lookupValuer[T](o.value); ok {
+		return v, err
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
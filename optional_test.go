@@ -0,0 +1,135 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iamNilotpal/maybe"
+)
+
+// userPatch models a typical HTTP PATCH payload, where every field is
+// independently unset/null/set.
+type userPatch struct {
+	Name     maybe.Optional[string] `json:"name"`
+	Nickname maybe.Optional[string] `json:"nickname"`
+	Age      maybe.Optional[int]    `json:"age"`
+}
+
+// TestOptionalJSONStates verifies that Unmarshal distinguishes an absent
+// field from one explicitly sent as null.
+func TestOptionalJSONStates(t *testing.T) {
+	var patch userPatch
+	err := json.Unmarshal([]byte(`{"name":"Ada","nickname":null}`), &patch)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if !patch.Name.IsSet() {
+		t.Error("Name should be Set after being provided in the payload")
+	}
+	if got, _ := patch.Name.Get(); got != "Ada" {
+		t.Errorf("Name = %q, want Ada", got)
+	}
+
+	if !patch.Nickname.IsNull() {
+		t.Error("Nickname should be Null when the payload sends null")
+	}
+
+	if !patch.Age.IsUnset() {
+		t.Error("Age should be Unset when omitted from the payload")
+	}
+}
+
+// TestMarshalJSONObjectSkipsUnset verifies that MarshalJSONObject omits
+// Unset fields entirely, matching PATCH's "only touch what was sent"
+// semantics, while Null fields still serialize as null.
+func TestMarshalJSONObjectSkipsUnset(t *testing.T) {
+	patch := userPatch{
+		Name:     maybe.OptionalOf("Ada"),
+		Nickname: maybe.OptionalNull[string](),
+	}
+
+	data, err := maybe.MarshalJSONObject(patch)
+	if err != nil {
+		t.Fatalf("MarshalJSONObject() error = %v", err)
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("re-unmarshal of MarshalJSONObject output failed: %v", err)
+	}
+
+	if _, present := out["age"]; present {
+		t.Errorf("unset field \"age\" should be omitted, got %s", data)
+	}
+	if v, present := out["nickname"]; !present || v != nil {
+		t.Errorf("null field \"nickname\" should serialize as null, got %s", data)
+	}
+	if v, present := out["name"]; !present || v != "Ada" {
+		t.Errorf("set field \"name\" should serialize as \"Ada\", got %s", data)
+	}
+}
+
+// addressPatch nests an Optional field one level deep, the realistic PATCH
+// shape MarshalJSONObject must recurse into.
+type addressPatch struct {
+	City maybe.Optional[string] `json:"city"`
+}
+
+type personPatch struct {
+	Addr addressPatch `json:"addr"`
+}
+
+// TestMarshalJSONObjectRecursesNestedStructs verifies that an Unset
+// Optional[T] nested inside a non-Optional struct field is still omitted,
+// instead of serializing as "city":null via encoding/json's default
+// encoding of the nested struct.
+func TestMarshalJSONObjectRecursesNestedStructs(t *testing.T) {
+	patch := personPatch{Addr: addressPatch{City: maybe.UnsetOptional[string]()}}
+
+	data, err := maybe.MarshalJSONObject(patch)
+	if err != nil {
+		t.Fatalf("MarshalJSONObject() error = %v", err)
+	}
+
+	if string(data) != `{"addr":{}}` {
+		t.Errorf("MarshalJSONObject() = %s, want {\"addr\":{}}", data)
+	}
+}
+
+// TestOptionalTimeScan verifies that Optional[time.Time], like
+// Nullable[time.Time], coerces the string shape MySQL returns for
+// DATETIME/TIMESTAMP columns without parseTime=true.
+func TestOptionalTimeScan(t *testing.T) {
+	var o maybe.Optional[time.Time]
+	if err := o.Scan("2024-01-02 15:04:05"); err != nil || !o.IsSet() {
+		t.Fatalf("Scan(datetime string) error = %v, set = %v", err, o.IsSet())
+	}
+
+	got, _ := o.Get()
+	if got.Format("2006-01-02 15:04:05") != "2024-01-02 15:04:05" {
+		t.Errorf("parsed time = %v, want 2024-01-02 15:04:05", got)
+	}
+}
+
+// TestOptionalConversions checks the collapsing conversions to Nullable and
+// Option.
+func TestOptionalConversions(t *testing.T) {
+	set := maybe.OptionalOf(42)
+	if n := set.ToNullable(); !n.IsValid() || n.ExtractOr(0) != 42 {
+		t.Error("Set -> Nullable should be valid with the same value")
+	}
+	if o := set.ToOption(); !o.IsSome() || o.Unwrap() != 42 {
+		t.Error("Set -> Option should be Some with the same value")
+	}
+
+	for _, o := range []maybe.Optional[int]{maybe.UnsetOptional[int](), maybe.OptionalNull[int]()} {
+		if o.ToNullable().IsValid() {
+			t.Error("Unset/Null -> Nullable should be invalid")
+		}
+		if o.ToOption().IsSome() {
+			t.Error("Unset/Null -> Option should be None")
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package maybe
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	registryMu     sync.RWMutex
+	parserRegistry = map[reflect.Type]func(any) (any, error){}
+	valuerRegistry = map[reflect.Type]func(any) (driver.Value, error){}
+)
+
+// RegisterParser installs parse as the Scan-time coercion for Nullable[T]
+// and Optional[T]. It runs for every non-nil scanned value, including one
+// whose concrete type is already T, ahead of the direct type assertion and
+// the built-in reflection-based coercion, so it's the right place to hang
+// enum parsing, custom string formats, or anything convertToType can't
+// express. Registering a Parser for T replaces any Parser already
+// registered for T.
+func RegisterParser[T any](parse func(any) (T, error)) {
+	t := reflect.TypeOf(*new(T))
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	parserRegistry[t] = func(v any) (any, error) { return parse(v) }
+}
+
+// RegisterValuer installs to as the Value-time conversion for Nullable[T]
+// and Optional[T], taking priority over driver.DefaultParameterConverter.
+func RegisterValuer[T any](to func(T) (driver.Value, error)) {
+	t := reflect.TypeOf(*new(T))
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	valuerRegistry[t] = func(v any) (driver.Value, error) {
+		tv, ok := v.(T)
+		if !ok {
+			return nil, fmt.Errorf("maybe: valuer registered for %s received %T", t, v)
+		}
+		return to(tv)
+	}
+}
+
+// MustRegisterEnum registers a Parser for T that accepts string/[]byte/T
+// input and rejects anything outside values, so Scan fails fast on data
+// that doesn't belong to the enum instead of silently accepting it.
+func MustRegisterEnum[T ~string](values ...T) {
+	allowed := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		allowed[v] = struct{}{}
+	}
+
+	RegisterParser(func(raw any) (T, error) {
+		var zero T
+
+		var s string
+		switch v := raw.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		case T:
+			s = string(v)
+		default:
+			return zero, &ScanError{Source: reflect.TypeOf(raw), Target: reflect.TypeOf(zero)}
+		}
+
+		candidate := T(s)
+		if _, ok := allowed[candidate]; !ok {
+			return zero, fmt.Errorf("maybe: %q is not a registered value for enum %T", s, zero)
+		}
+		return candidate, nil
+	})
+}
+
+func init() {
+	registerBuiltinParser[int]()
+	registerBuiltinParser[int8]()
+	registerBuiltinParser[int16]()
+	registerBuiltinParser[int32]()
+	registerBuiltinParser[int64]()
+	registerBuiltinParser[uint]()
+	registerBuiltinParser[uint8]()
+	registerBuiltinParser[uint16]()
+	registerBuiltinParser[uint32]()
+	registerBuiltinParser[uint64]()
+	registerBuiltinParser[float32]()
+	registerBuiltinParser[float64]()
+	registerBuiltinParser[string]()
+	registerBuiltinParser[bool]()
+	registerBuiltinParser[[]byte]()
+}
+
+// registerBuiltinParser wraps convertToType as a Parser, so the built-in
+// numeric/string/[]byte widenings are reachable through the same registry
+// lookup as user-registered parsers.
+func registerBuiltinParser[T any]() {
+	RegisterParser(func(v any) (T, error) {
+		out, ok := convertToType[T](v)
+		if !ok {
+			var zero T
+			return zero, &ScanError{Source: reflect.TypeOf(v), Target: reflect.TypeOf(zero)}
+		}
+		return out, nil
+	})
+}
+
+// lookupParser reports whether a Parser is registered for T and, if so,
+// runs it against value.
+func lookupParser[T any](value any) (t T, found bool, err error) {
+	target := reflect.TypeOf(*new(T))
+
+	registryMu.RLock()
+	parse, ok := parserRegistry[target]
+	registryMu.RUnlock()
+	if !ok {
+		return t, false, nil
+	}
+
+	out, err := parse(value)
+	if err != nil {
+		return t, true, err
+	}
+
+	v, ok := out.(T)
+	if !ok {
+		return t, true, fmt.Errorf("maybe: parser registered for %s returned %T", target, out)
+	}
+	return v, true, nil
+}
+
+// lookupValuer reports whether a Valuer is registered for T and, if so,
+// runs it against value.
+func lookupValuer[T any](value T) (driver.Value, bool, error) {
+	target := reflect.TypeOf(*new(T))
+
+	registryMu.RLock()
+	to, ok := valuerRegistry[target]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, false, nil
+	}
+
+	v, err := to(value)
+	return v, true, err
+}
@@ -0,0 +1,87 @@
+package maybe_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/iamNilotpal/maybe"
+)
+
+// Role is a string-backed enum, the motivating case for RegisterParser and
+// MustRegisterEnum.
+type Role string
+
+const (
+	RoleAdmin Role = "admin"
+	RoleUser  Role = "user"
+)
+
+func init() {
+	maybe.MustRegisterEnum(RoleAdmin, RoleUser)
+}
+
+// TestMustRegisterEnumScan verifies that Scan accepts registered enum
+// members and rejects anything else.
+func TestMustRegisterEnumScan(t *testing.T) {
+	var n maybe.Nullable[Role]
+	if err := n.Scan("admin"); err != nil || !n.IsValid() || n.ExtractOr("") != RoleAdmin {
+		t.Errorf("Scan(\"admin\") = (valid=%v, err=%v), want valid RoleAdmin", n.IsValid(), err)
+	}
+
+	var bad maybe.Nullable[Role]
+	if err := bad.Scan("superuser"); err == nil {
+		t.Error("Scan(\"superuser\") should fail for an unregistered enum value")
+	}
+}
+
+// TestMustRegisterEnumScanDirectType verifies that membership is still
+// validated when the scanned value's concrete type is already T, not just
+// when Scan has to coerce from string/[]byte.
+func TestMustRegisterEnumScanDirectType(t *testing.T) {
+	var n maybe.Nullable[Role]
+	if err := n.Scan(Role("superuser")); err == nil {
+		t.Error("Scan(Role(\"superuser\")) should fail even though the value is already a Role")
+	}
+
+	var o maybe.Optional[Role]
+	if err := o.Scan(Role("superuser")); err == nil {
+		t.Error("Optional.Scan(Role(\"superuser\")) should fail even though the value is already a Role")
+	}
+}
+
+// Cents is an int-backed type exercised through a custom Parser/Valuer pair.
+type Cents int64
+
+// TestRegisterParserAndValuer verifies that a custom Parser/Valuer pair
+// takes effect for both Scan and Value.
+func TestRegisterParserAndValuer(t *testing.T) {
+	maybe.RegisterParser(func(v any) (Cents, error) {
+		s, ok := v.(string)
+		if !ok {
+			return 0, errors.New("Cents parser expects a string")
+		}
+		// Accepts a "1234" formatted string as cents.
+		var cents int64
+		for _, r := range s {
+			if r < '0' || r > '9' {
+				return 0, errors.New("Cents parser expects digits only")
+			}
+			cents = cents*10 + int64(r-'0')
+		}
+		return Cents(cents), nil
+	})
+	maybe.RegisterValuer(func(c Cents) (driver.Value, error) {
+		return int64(c), nil
+	})
+
+	var n maybe.Nullable[Cents]
+	if err := n.Scan("1234"); err != nil || !n.IsValid() || n.ExtractOr(0) != 1234 {
+		t.Errorf("Scan(\"1234\") = (valid=%v, err=%v), want valid Cents(1234)", n.IsValid(), err)
+	}
+
+	val, err := n.Value()
+	if err != nil || val != int64(1234) {
+		t.Errorf("Value() = (%v, %v), want int64(1234)", val, err)
+	}
+}
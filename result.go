@@ -0,0 +1,68 @@
+package maybe
+
+// Result represents the outcome of a fallible operation: either Ok(value)
+// or Err(err). The zero value is Ok with T's zero value, so callers should
+// construct one through Ok or Err rather than relying on it.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok wraps value in a successful Result.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err wraps err in a failed Result[T].
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// IsOk reports whether r succeeded.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether r failed.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unwrap returns the held value, panicking if r is an error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic("maybe: Unwrap called on Err: " + r.err.Error())
+	}
+	return r.value
+}
+
+// UnwrapOr returns the held value, or fallback if r is an error.
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// Get returns the held value and error.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// MapResult applies fn to the held value and returns the transformed
+// Result. An Err propagates without invoking fn.
+func MapResult[T, U any](r Result[T], fn func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.value))
+}
+
+// AndThenResult chains fn onto the held value, flattening the resulting
+// Result. An Err propagates without invoking fn.
+func AndThenResult[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return fn(r.value)
+}
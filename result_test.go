@@ -0,0 +1,49 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iamNilotpal/maybe"
+)
+
+// TestResultOkErr covers the basic Ok/Err constructors and accessors.
+func TestResultOkErr(t *testing.T) {
+	ok := maybe.Ok(42)
+	if !ok.IsOk() || ok.IsErr() || ok.Unwrap() != 42 {
+		t.Error("Ok(42) should be Ok and unwrap to 42")
+	}
+
+	wantErr := errors.New("boom")
+	failed := maybe.Err[int](wantErr)
+	if failed.IsOk() || !failed.IsErr() {
+		t.Error("Err(boom) should be Err")
+	}
+	if _, err := failed.Get(); !errors.Is(err, wantErr) {
+		t.Errorf("Get() error = %v, want %v", err, wantErr)
+	}
+	if failed.UnwrapOr(-1) != -1 {
+		t.Error("UnwrapOr on Err should return the fallback")
+	}
+}
+
+// TestResultMapAndThen mirrors Option's Map/AndThen semantics: both
+// propagate an existing error without invoking fn.
+func TestResultMapAndThen(t *testing.T) {
+	doubled := maybe.MapResult(maybe.Ok(21), func(v int) int { return v * 2 })
+	if doubled.Unwrap() != 42 {
+		t.Errorf("MapResult(Ok(21), double) = %d, want 42", doubled.Unwrap())
+	}
+
+	propagated := maybe.MapResult(maybe.Err[int](errors.New("boom")), func(v int) int { return v * 2 })
+	if !propagated.IsErr() {
+		t.Error("MapResult should propagate an existing error without calling fn")
+	}
+
+	chained := maybe.AndThenResult(maybe.Ok(5), func(v int) maybe.Result[string] {
+		return maybe.Ok("ok")
+	})
+	if chained.Unwrap() != "ok" {
+		t.Errorf("AndThenResult(Ok(5), ...) = %q, want ok", chained.Unwrap())
+	}
+}
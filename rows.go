@@ -0,0 +1,42 @@
+package maybe
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// ErrNull is returned by Nullable[T].ScanInto when the Nullable holds no
+// value, so callers can compose errors.Is(err, maybe.ErrNull) while
+// iterating rows instead of checking IsValid() separately.
+var ErrNull = errors.New("maybe: value is null")
+
+// ScanInto copies n's value into dest, or returns ErrNull if n is invalid.
+func (n Nullable[T]) ScanInto(dest *T) error {
+	if !n.valid {
+		return ErrNull
+	}
+	*dest = n.value
+	return nil
+}
+
+// ScanRows drains rows, calling dst for each row to obtain the Scan targets
+// and build to assemble the resulting T from whatever dst scanned into
+// (typically variables captured by both closures). It replaces the
+// boilerplate rows.Next/Scan/err loop callers otherwise repeat for every
+// query that reads nullable columns.
+func ScanRows[T any](rows *sql.Rows, dst func() []any, build func() T) ([]T, error) {
+	defer rows.Close()
+
+	var out []T
+	for rows.Next() {
+		if err := rows.Scan(dst()...); err != nil {
+			return nil, err
+		}
+		out = append(out, build())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,129 @@
+package maybe_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/iamNilotpal/maybe"
+)
+
+// fakeRows is a minimal driver.Rows backing fixed data, just enough to
+// exercise ScanRows without a real database.
+type fakeRows struct {
+	cols []string
+	data [][]driver.Value
+	pos  int
+}
+
+func (r *fakeRows) Columns() []string { return r.cols }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.data) {
+		return io.EOF
+	}
+	copy(dest, r.data[r.pos])
+	r.pos++
+	return nil
+}
+
+type fakeStmt struct{ rows *fakeRows }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("fakeStmt: Exec not supported")
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) { return s.rows, nil }
+
+type fakeConn struct{ rows *fakeRows }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{rows: c.rows}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fakeConn: Begin not supported")
+}
+
+type fakeDriver struct{ rows *fakeRows }
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return &fakeConn{rows: d.rows}, nil }
+
+// scanRowsDriverRows is the fixed-identity fakeRows backing the
+// "maybe-fake-scanrows" driver. sql.Register panics if called twice for the
+// same name, so the driver is registered once via sync.Once and each test
+// run resets this shared instance instead of re-registering.
+var (
+	scanRowsDriverOnce sync.Once
+	scanRowsDriverRows = &fakeRows{}
+)
+
+// TestScanRows verifies that ScanRows drains every row, applying dst/build
+// per row, and surfaces the usual nullable-column shapes along the way.
+func TestScanRows(t *testing.T) {
+	scanRowsDriverRows.cols = []string{"id", "name"}
+	scanRowsDriverRows.data = [][]driver.Value{
+		{int64(1), []byte("Ada")},
+		{int64(2), nil},
+	}
+	scanRowsDriverRows.pos = 0
+
+	scanRowsDriverOnce.Do(func() {
+		sql.Register("maybe-fake-scanrows", &fakeDriver{rows: scanRowsDriverRows})
+	})
+
+	db, err := sql.Open("maybe-fake-scanrows", "")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	defer db.Close()
+
+	type person struct {
+		ID   int64
+		Name maybe.Nullable[string]
+	}
+
+	sqlRows, err := db.Query("SELECT id, name FROM people")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+
+	var id int64
+	var name maybe.Nullable[string]
+	people, err := maybe.ScanRows(
+		sqlRows,
+		func() []any { return []any{&id, &name} },
+		func() person { return person{ID: id, Name: name} },
+	)
+	if err != nil {
+		t.Fatalf("ScanRows() error = %v", err)
+	}
+
+	if len(people) != 2 {
+		t.Fatalf("len(people) = %d, want 2", len(people))
+	}
+	if people[0].ID != 1 || people[0].Name.ExtractOr("") != "Ada" {
+		t.Errorf("people[0] = %+v, want ID=1 Name=Ada", people[0])
+	}
+	if people[1].ID != 2 || people[1].Name.IsValid() {
+		t.Errorf("people[1] = %+v, want ID=2 Name=null", people[1])
+	}
+}
+
+// TestNullableScanInto verifies the ErrNull sentinel composition described
+// in the request: errors.Is against maybe.ErrNull.
+func TestNullableScanInto(t *testing.T) {
+	var dest string
+	valid := maybe.NullableOf("hi")
+	if err := valid.ScanInto(&dest); err != nil || dest != "hi" {
+		t.Errorf("ScanInto() = (%q, %v), want (hi, nil)", dest, err)
+	}
+
+	null := maybe.Null[string]()
+	if err := null.ScanInto(&dest); !errors.Is(err, maybe.ErrNull) {
+		t.Errorf("ScanInto() on null Nullable error = %v, want ErrNull", err)
+	}
+}
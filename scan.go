@@ -0,0 +1,45 @@
+package maybe
+
+import "reflect"
+
+// scanCoerce implements the Scan coercion pipeline shared by Nullable[T]
+// and Optional[T]: a registered Parser (if any) runs first so it can
+// validate a value already of type T, then a direct type assertion, then a
+// time.Time string/[]byte special case, then the general reflection-based
+// convertToType fallback. The caller is responsible for handling a nil
+// value (SQL NULL) before calling scanCoerce.
+func scanCoerce[T any](value any) (result T, ok bool, err error) {
+	if parsed, handled, parseErr := lookupParser[T](value); handled {
+		if parseErr != nil {
+			return result, false, parseErr
+		}
+		return parsed, true, nil
+	}
+
+	if v, ok := value.(T); ok {
+		return v, true, nil
+	}
+
+	if reflect.TypeOf(*new(T)) == timeType {
+		var raw string
+		switch src := value.(type) {
+		case string:
+			raw = src
+		case []byte:
+			raw = string(src)
+		}
+
+		if raw != "" {
+			if t, ok := parseTimeString(raw); ok {
+				return any(t).(T), true, nil
+			}
+		}
+	}
+
+	if converted, ok := convertToType[T](value); ok {
+		return converted, true, nil
+	}
+
+	var zero T
+	return zero, false, &ScanError{Source: reflect.TypeOf(value), Target: reflect.TypeOf(zero)}
+}
@@ -0,0 +1,53 @@
+package maybe
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultTimeFormats are tried, in order, when a driver hands Scan a string
+// or []byte for a Nullable[time.Time] column (e.g. MySQL DATETIME/TIMESTAMP
+// columns read back without parseTime=true).
+var defaultTimeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+var (
+	timeFormatsMu         sync.RWMutex
+	registeredTimeFormats = append([]string(nil), defaultTimeFormats...)
+)
+
+// RegisterTimeFormats replaces the layouts Nullable[time.Time].Scan tries
+// when coercing a string or []byte value, in the order given. Passing no
+// formats resets the registry to its built-in defaults.
+func RegisterTimeFormats(formats ...string) {
+	timeFormatsMu.Lock()
+	defer timeFormatsMu.Unlock()
+
+	if len(formats) == 0 {
+		registeredTimeFormats = append([]string(nil), defaultTimeFormats...)
+		return
+	}
+	registeredTimeFormats = append([]string(nil), formats...)
+}
+
+// currentTimeFormats returns a snapshot of the registered layouts.
+func currentTimeFormats() []string {
+	timeFormatsMu.RLock()
+	defer timeFormatsMu.RUnlock()
+	return registeredTimeFormats
+}
+
+// parseTimeString tries each registered layout against s, returning the
+// first successful parse.
+func parseTimeString(s string) (time.Time, bool) {
+	for _, layout := range currentTimeFormats() {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}